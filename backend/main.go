@@ -1,17 +1,26 @@
 package main
 
 import (
+	"bufio"
 	"container/list"
 	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
+	"os/exec"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/gin-gonic/gin"
 	"github.com/redis/go-redis/v9"
 )
@@ -21,6 +30,15 @@ const (
 	CacheMaxSize     = 50
 	CacheTTL         = 10 * time.Minute
 	RedisTTL         = 30 * time.Minute
+
+	// DefaultScanCount is the SCAN COUNT hint used when paging through
+	// component:* keys, chosen to keep each Redis round-trip cheap
+	// without blocking the event loop on a KEYS-style full scan.
+	DefaultScanCount = 3000
+
+	// SessionCursorTTL is how long a client's resumable scan cursor is
+	// kept before it's treated as abandoned.
+	SessionCursorTTL = 5 * time.Minute
 )
 
 // TTLCache implements a simple LRU cache with TTL
@@ -121,22 +139,773 @@ func (c *TTLCache) Clear() {
 	c.timestamps = make(map[string]time.Time)
 }
 
-// ConcurrencyLimiter middleware to limit concurrent requests
-func ConcurrencyLimiter(limit int) gin.HandlerFunc {
-	semaphore := make(chan struct{}, limit)
+// Delete removes a single key from the cache, if present.
+func (c *TTLCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	element, exists := c.cache[key]
+	if !exists {
+		return
+	}
+	c.lruList.Remove(element)
+	delete(c.cache, key)
+	delete(c.timestamps, key)
+}
+
+// DeletePrefix removes every key with the given prefix. Used to invalidate
+// every cached rendering of one component type after its template changes,
+// without flushing the whole cache.
+func (c *TTLCache) DeletePrefix(prefix string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, element := range c.cache {
+		if strings.HasPrefix(key, prefix) {
+			c.lruList.Remove(element)
+			delete(c.cache, key)
+			delete(c.timestamps, key)
+		}
+	}
+}
 
-	return func(c *gin.Context) {
+// Request priorities, used to reorder the limiter's wait queue. Higher
+// value is served first.
+const (
+	PriorityLow    = 0
+	PriorityNormal = 1
+	PriorityHigh   = 2
+)
+
+// LimiterConfig configures the adaptive concurrency limiter: how many
+// slots each route gets, how long a request will queue before giving up,
+// and the latency target the adaptive controller aims to stay under.
+type LimiterConfig struct {
+	// RouteBudgets maps a route pattern (as gin.Context.FullPath reports
+	// it, e.g. "/api/component/:component_type") to its slot count.
+	// Routes not listed use DefaultBudget.
+	RouteBudgets  map[string]int
+	DefaultBudget int
+
+	// MinLimit is the floor the adaptive controller won't shrink below,
+	// even under sustained high latency.
+	MinLimit int
+
+	// MaxWait is how long an excess request queues before it's rejected
+	// with a 503 instead of failing immediately.
+	MaxWait time.Duration
+
+	// TargetP95Latency is the latency the adaptive controller tries to
+	// keep the route's p95 under; exceeding it shrinks the limit.
+	TargetP95Latency time.Duration
+
+	// ExemptPrefixes are path prefixes (e.g. "/admin") that skip limiting
+	// entirely, for operator/monitoring traffic.
+	ExemptPrefixes []string
+}
+
+// DefaultLimiterConfig returns sane defaults: the historical global
+// ConcurrencyLimit as the default budget, a 2s queueing window, and a
+// 500ms p95 latency target.
+func DefaultLimiterConfig() LimiterConfig {
+	return LimiterConfig{
+		RouteBudgets:     map[string]int{},
+		DefaultBudget:    ConcurrencyLimit,
+		MinLimit:         1,
+		MaxWait:          2 * time.Second,
+		TargetP95Latency: 500 * time.Millisecond,
+		ExemptPrefixes:   []string{"/admin"},
+	}
+}
+
+func (cfg LimiterConfig) isExempt(path string) bool {
+	for _, prefix := range cfg.ExemptPrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (cfg LimiterConfig) budgetFor(routeKey string) int {
+	if budget, ok := cfg.RouteBudgets[routeKey]; ok {
+		return budget
+	}
+	return cfg.DefaultBudget
+}
+
+// routeLimiter tracks in-flight requests, a priority wait queue, and a
+// recent-latency window for one route, and adapts its effective limit
+// to keep p95 latency near cfg.TargetP95Latency (a Little's-law-style
+// controller: as latency rises above target, shrink the limit so
+// in-flight * latency stays bounded; as latency stays comfortably under
+// target, grow back toward the configured budget).
+type routeLimiter struct {
+	cfg LimiterConfig
+
+	mu        sync.Mutex
+	baseLimit int
+	limit     int
+	inFlight  int
+	queue     [3][]chan struct{}
+	latencies []time.Duration
+}
+
+func newRouteLimiter(cfg LimiterConfig, baseLimit int) *routeLimiter {
+	return &routeLimiter{cfg: cfg, baseLimit: baseLimit, limit: baseLimit}
+}
+
+// acquire blocks until a slot is free or cfg.MaxWait elapses, honoring
+// priority order among queued waiters. It returns false if the wait
+// timed out.
+func (r *routeLimiter) acquire(priority int) bool {
+	r.mu.Lock()
+	if r.inFlight < r.limit {
+		r.inFlight++
+		r.mu.Unlock()
+		return true
+	}
+
+	ready := make(chan struct{}, 1)
+	r.queue[priority] = append(r.queue[priority], ready)
+	r.mu.Unlock()
+
+	timer := time.NewTimer(r.cfg.MaxWait)
+	defer timer.Stop()
+
+	select {
+	case <-ready:
+		return true
+	case <-timer.C:
+		r.mu.Lock()
+		// release() may have already popped us off the queue and sent on
+		// ready (a buffered channel) in the instant before the timer
+		// fired, in which case select above could have picked either
+		// case. Check under the lock before concluding we timed out,
+		// otherwise the transferred slot is lost: release() doesn't
+		// decrement inFlight on handoff, and we'd return false without
+		// ever claiming it.
 		select {
-		case semaphore <- struct{}{}:
-			defer func() { <-semaphore }()
-			c.Next()
+		case <-ready:
+			r.mu.Unlock()
+			return true
 		default:
+			r.removeFromQueue(priority, ready)
+			r.mu.Unlock()
+			return false
+		}
+	}
+}
+
+func (r *routeLimiter) removeFromQueue(priority int, ready chan struct{}) {
+	q := r.queue[priority]
+	for i, c := range q {
+		if c == ready {
+			r.queue[priority] = append(q[:i], q[i+1:]...)
+			return
+		}
+	}
+}
+
+// release frees the caller's slot, observes latency for the adaptive
+// controller, and either hands the slot to the next queued waiter
+// (highest priority first) or returns it to the pool.
+func (r *routeLimiter) release(latency time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.recordLatency(latency)
+	r.adapt()
+
+	if r.inFlight > r.limit {
+		// The limit shrank below what's in flight: retire this slot
+		// instead of handing it to a waiter.
+		r.inFlight--
+		return
+	}
+
+	for p := PriorityHigh; p >= PriorityLow; p-- {
+		q := r.queue[p]
+		if len(q) == 0 {
+			continue
+		}
+		next := q[0]
+		r.queue[p] = q[1:]
+		next <- struct{}{}
+		return // slot transferred directly to the waiter; inFlight unchanged
+	}
+
+	r.inFlight--
+}
+
+const latencyWindowSize = 50
+
+func (r *routeLimiter) recordLatency(d time.Duration) {
+	r.latencies = append(r.latencies, d)
+	if len(r.latencies) > latencyWindowSize {
+		r.latencies = r.latencies[len(r.latencies)-latencyWindowSize:]
+	}
+}
+
+// adapt shrinks the effective limit when recent p95 latency exceeds
+// target, and grows it back toward baseLimit when latency has headroom.
+func (r *routeLimiter) adapt() {
+	if len(r.latencies) < 5 {
+		return
+	}
+	p95 := percentile(r.latencies, 0.95)
+	if p95 > r.cfg.TargetP95Latency {
+		if r.limit > r.cfg.MinLimit {
+			r.limit--
+		}
+	} else if r.limit < r.baseLimit {
+		r.limit++
+	}
+}
+
+func (r *routeLimiter) snapshot() (limit, inFlight, queueDepth int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	depth := 0
+	for _, q := range r.queue {
+		depth += len(q)
+	}
+	return r.limit, r.inFlight, depth
+}
+
+// percentile returns the pth percentile (0..1) of durations, sorted by
+// value. A small copy+sort is cheap at the window sizes this limiter
+// keeps (latencyWindowSize entries).
+func percentile(durations []time.Duration, p float64) time.Duration {
+	sorted := append([]time.Duration(nil), durations...)
+	for i := 1; i < len(sorted); i++ {
+		for j := i; j > 0 && sorted[j-1] > sorted[j]; j-- {
+			sorted[j-1], sorted[j] = sorted[j], sorted[j-1]
+		}
+	}
+	idx := int(p * float64(len(sorted)))
+	if idx >= len(sorted) {
+		idx = len(sorted) - 1
+	}
+	return sorted[idx]
+}
+
+// parsePriority maps the X-Priority header to a priority level,
+// defaulting to normal for missing or unrecognized values.
+func parsePriority(header string) int {
+	switch strings.ToLower(header) {
+	case "high":
+		return PriorityHigh
+	case "low":
+		return PriorityLow
+	default:
+		return PriorityNormal
+	}
+}
+
+// limiterRegistry holds one routeLimiter per matched route, created
+// lazily so each route's budget is only allocated once it's first hit.
+type limiterRegistry struct {
+	mu       sync.Mutex
+	cfg      LimiterConfig
+	limiters map[string]*routeLimiter
+}
+
+func newLimiterRegistry(cfg LimiterConfig) *limiterRegistry {
+	return &limiterRegistry{cfg: cfg, limiters: make(map[string]*routeLimiter)}
+}
+
+func (reg *limiterRegistry) get(routeKey string) *routeLimiter {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	if rl, ok := reg.limiters[routeKey]; ok {
+		return rl
+	}
+	rl := newRouteLimiter(reg.cfg, reg.cfg.budgetFor(routeKey))
+	reg.limiters[routeKey] = rl
+	return rl
+}
+
+func (reg *limiterRegistry) snapshot() []gin.H {
+	reg.mu.Lock()
+	routeKeys := make([]string, 0, len(reg.limiters))
+	limiters := make([]*routeLimiter, 0, len(reg.limiters))
+	for key, rl := range reg.limiters {
+		routeKeys = append(routeKeys, key)
+		limiters = append(limiters, rl)
+	}
+	reg.mu.Unlock()
+
+	stats := make([]gin.H, 0, len(limiters))
+	for i, rl := range limiters {
+		limit, inFlight, queueDepth := rl.snapshot()
+		stats = append(stats, gin.H{
+			"route":       routeKeys[i],
+			"limit":       limit,
+			"in_flight":   inFlight,
+			"queue_depth": queueDepth,
+		})
+	}
+	return stats
+}
+
+// ConcurrencyLimiter is gin middleware that enforces per-route concurrency
+// budgets with bounded queueing instead of failing excess requests
+// immediately, adapts each route's effective limit to observed p95
+// latency, and lets callers jump the wait queue via an X-Priority header.
+func ConcurrencyLimiter(reg *limiterRegistry) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if reg.cfg.isExempt(c.Request.URL.Path) {
+			c.Next()
+			return
+		}
+
+		routeKey := c.FullPath()
+		if routeKey == "" {
+			routeKey = c.Request.URL.Path
+		}
+		rl := reg.get(routeKey)
+
+		priority := parsePriority(c.GetHeader("X-Priority"))
+		start := time.Now()
+
+		if !rl.acquire(priority) {
+			_, _, queueDepth := rl.snapshot()
+			c.Header("Retry-After", fmt.Sprintf("%.0f", reg.cfg.MaxWait.Seconds()))
 			c.JSON(http.StatusServiceUnavailable, gin.H{
-				"error": "server is at capacity, please try again later",
+				"error":       "server is at capacity, please try again later",
+				"queue_depth": queueDepth,
 			})
 			c.Abort()
+			return
+		}
+
+		defer func() { rl.release(time.Since(start)) }()
+		c.Next()
+	}
+}
+
+// OIDCConfig configures bearer-token authentication for the admin API.
+type OIDCConfig struct {
+	IssuerURL string
+	ClientID  string
+
+	// UserClaim is the claim used as the acting username, logged/returned
+	// on writes for auditability.
+	UserClaim string
+
+	// RequiredGroupClaim/RequiredGroup gate writes on group membership,
+	// e.g. RequiredGroupClaim="groups", RequiredGroup="localization-admins".
+	// RequiredGroup is mandatory: main refuses to register the admin
+	// group at all if it's unset, since otherwise any caller with a
+	// merely valid token for the issuer - not necessarily an admin -
+	// would be authorized to write templates that the SSR worker pool
+	// later compiles and executes.
+	RequiredGroupClaim string
+	RequiredGroup      string
+}
+
+// oidcConfigFromEnv builds an OIDCConfig from environment variables.
+func oidcConfigFromEnv() OIDCConfig {
+	cfg := OIDCConfig{
+		IssuerURL:          os.Getenv("OIDC_ISSUER_URL"),
+		ClientID:           os.Getenv("OIDC_CLIENT_ID"),
+		UserClaim:          os.Getenv("OIDC_USER_CLAIM"),
+		RequiredGroupClaim: os.Getenv("OIDC_GROUP_CLAIM"),
+		RequiredGroup:      os.Getenv("OIDC_REQUIRED_GROUP"),
+	}
+	if cfg.UserClaim == "" {
+		cfg.UserClaim = "preferred_username"
+	}
+	if cfg.RequiredGroupClaim == "" {
+		cfg.RequiredGroupClaim = "groups"
+	}
+	return cfg
+}
+
+// newOIDCVerifier discovers the issuer's OIDC configuration and returns a
+// verifier for ID/access tokens it signs.
+func newOIDCVerifier(ctx context.Context, cfg OIDCConfig) (*oidc.IDTokenVerifier, error) {
+	if cfg.IssuerURL == "" {
+		return nil, fmt.Errorf("OIDC_ISSUER_URL is not set")
+	}
+	provider, err := oidc.NewProvider(ctx, cfg.IssuerURL)
+	if err != nil {
+		return nil, fmt.Errorf("discovering OIDC provider: %w", err)
+	}
+	return provider.Verifier(&oidc.Config{ClientID: cfg.ClientID}), nil
+}
+
+// OIDCAuth validates the bearer token on admin requests, requires the
+// configured user claim, and (if RequiredGroup is set) checks the caller
+// is a member before allowing the request through.
+func OIDCAuth(verifier *oidc.IDTokenVerifier, cfg OIDCConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authHeader := c.GetHeader("Authorization")
+		if !strings.HasPrefix(authHeader, "Bearer ") {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "missing bearer token"})
+			c.Abort()
+			return
+		}
+		rawToken := strings.TrimPrefix(authHeader, "Bearer ")
+
+		idToken, err := verifier.Verify(c.Request.Context(), rawToken)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid token: " + err.Error()})
+			c.Abort()
+			return
+		}
+
+		var claims map[string]interface{}
+		if err := idToken.Claims(&claims); err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid token claims"})
+			c.Abort()
+			return
+		}
+
+		username, _ := claims[cfg.UserClaim].(string)
+		if username == "" {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": fmt.Sprintf("token missing %q claim", cfg.UserClaim)})
+			c.Abort()
+			return
+		}
+
+		if cfg.RequiredGroup != "" && !claimContainsValue(claims[cfg.RequiredGroupClaim], cfg.RequiredGroup) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "missing required group/role"})
+			c.Abort()
+			return
+		}
+
+		c.Set("oidc_username", username)
+		c.Next()
+	}
+}
+
+// claimContainsValue reports whether a JWT claim (a string or a list of
+// strings, as group/role claims commonly are) contains want.
+func claimContainsValue(raw interface{}, want string) bool {
+	switch v := raw.(type) {
+	case string:
+		return v == want
+	case []interface{}:
+		for _, item := range v {
+			if s, ok := item.(string); ok && s == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// --- SSR rendering -------------------------------------------------------
+//
+// ?render=ssr is served by a pool of long-lived Node subprocess workers:
+// a fixed-size pool of resources, acquired and released per request. Each
+// worker speaks a line-delimited JSON-RPC protocol over stdin/stdout:
+// one {template, localizedData, props} object in, one {html,
+// hydration_script} object out.
+
+const (
+	// SSRCacheTTL is how long a rendered HTML fragment is kept in Redis,
+	// separate from RedisTTL since rendered fragments are more expensive
+	// to regenerate than a raw template lookup.
+	SSRCacheTTL = 30 * time.Minute
+
+	// DefaultSSRPoolSize is how many Node worker subprocesses are started
+	// when SSR is enabled and SSR_POOL_SIZE isn't set.
+	DefaultSSRPoolSize = 2
+
+	// SSRRenderTimeout bounds how long a single render call waits on a
+	// worker before giving up and degrading to raw-template mode.
+	SSRRenderTimeout = 3 * time.Second
+)
+
+// ssrRenderRequest is sent to a worker as one line of JSON.
+type ssrRenderRequest struct {
+	Template      string                 `json:"template"`
+	LocalizedData map[string]string      `json:"localizedData"`
+	Props         map[string]interface{} `json:"props"`
+}
+
+// ssrRenderResponse is read back from a worker as one line of JSON. This
+// is also exactly what gets cached in Redis and returned to the client.
+type ssrRenderResponse struct {
+	HTML            string `json:"html"`
+	HydrationScript string `json:"hydration_script"`
+	Error           string `json:"error,omitempty"`
+}
+
+// ssrWorker wraps one Node subprocess. Requests to a single worker are
+// serialized by mu since the JSON-RPC protocol is one-in-one-out over a
+// shared pipe. stdin/stdoutFile are kept as *os.File (rather than the
+// io.WriteCloser/io.ReadCloser StdinPipe/StdoutPipe return) so render can
+// set an I/O deadline and actually bound a hung worker instead of
+// blocking forever.
+type ssrWorker struct {
+	mu         sync.Mutex
+	cmd        *exec.Cmd
+	stdin      *os.File
+	stdoutFile *os.File
+	stdout     *bufio.Reader
+	dead       bool // set once an I/O deadline trips or a pipe errors; the pool drops rather than reuses it
+}
+
+func spawnSSRWorker(scriptPath string) (*ssrWorker, error) {
+	cmd := exec.Command("node", scriptPath)
+	stdinPipe, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, fmt.Errorf("opening ssr worker stdin: %w", err)
+	}
+	stdoutPipe, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("opening ssr worker stdout: %w", err)
+	}
+	cmd.Stderr = os.Stderr
+
+	stdin, ok := stdinPipe.(*os.File)
+	if !ok {
+		return nil, fmt.Errorf("ssr worker stdin pipe does not support deadlines")
+	}
+	stdout, ok := stdoutPipe.(*os.File)
+	if !ok {
+		return nil, fmt.Errorf("ssr worker stdout pipe does not support deadlines")
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("starting ssr worker: %w", err)
+	}
+
+	return &ssrWorker{cmd: cmd, stdin: stdin, stdoutFile: stdout, stdout: bufio.NewReader(stdout)}, nil
+}
+
+// render sends one JSON-RPC request and waits for its response, bounded
+// by ctx's deadline (falling back to SSRRenderTimeout if ctx has none).
+// A hung worker - bad template, wedged Node process - hits the deadline
+// and returns an error instead of blocking the request forever; any I/O
+// error marks the worker dead so the pool drops it rather than reusing a
+// pipe that may now hold a partial, misaligned response.
+func (w *ssrWorker) render(ctx context.Context, req ssrRenderRequest) (ssrRenderResponse, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(SSRRenderTimeout)
+	}
+	if err := w.stdin.SetWriteDeadline(deadline); err != nil {
+		w.dead = true
+		return ssrRenderResponse{}, fmt.Errorf("setting ssr worker write deadline: %w", err)
+	}
+	if err := w.stdoutFile.SetReadDeadline(deadline); err != nil {
+		w.dead = true
+		return ssrRenderResponse{}, fmt.Errorf("setting ssr worker read deadline: %w", err)
+	}
+
+	line, err := json.Marshal(req)
+	if err != nil {
+		return ssrRenderResponse{}, err
+	}
+	if _, err := w.stdin.Write(append(line, '\n')); err != nil {
+		w.dead = true
+		return ssrRenderResponse{}, fmt.Errorf("writing to ssr worker: %w", err)
+	}
+
+	respLine, err := w.stdout.ReadString('\n')
+	if err != nil {
+		w.dead = true
+		return ssrRenderResponse{}, fmt.Errorf("reading from ssr worker: %w", err)
+	}
+
+	var resp ssrRenderResponse
+	if err := json.Unmarshal([]byte(respLine), &resp); err != nil {
+		w.dead = true
+		return ssrRenderResponse{}, fmt.Errorf("decoding ssr worker response: %w", err)
+	}
+	if resp.Error != "" {
+		// The worker itself answered fine; it just couldn't render this
+		// particular template. No reason to drop it.
+		return ssrRenderResponse{}, fmt.Errorf("ssr worker: %s", resp.Error)
+	}
+	return resp, nil
+}
+
+// isDead reports whether render observed an I/O failure on this worker.
+func (w *ssrWorker) isDead() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.dead
+}
+
+// ssrWorkerPool is a fixed-size pool of ssrWorker subprocesses, acquired
+// and released through a buffered channel. A crashed worker is
+// respawned in the background; the pool reports itself unhealthy only
+// once every worker has failed, so the handler can degrade gracefully.
+type ssrWorkerPool struct {
+	scriptPath string
+	available  chan *ssrWorker
+
+	mu      sync.Mutex
+	healthy int // count of currently-live workers
+}
+
+func newSSRWorkerPool(size int, scriptPath string) (*ssrWorkerPool, error) {
+	pool := &ssrWorkerPool{
+		scriptPath: scriptPath,
+		available:  make(chan *ssrWorker, size),
+	}
+	for i := 0; i < size; i++ {
+		w, err := spawnSSRWorker(scriptPath)
+		if err != nil {
+			return nil, err
+		}
+		pool.healthy++
+		pool.available <- w
+		go pool.watch(w)
+	}
+	return pool, nil
+}
+
+// watch waits for a worker's process to exit, then tries to respawn it so
+// the pool heals itself after a single worker crash.
+func (p *ssrWorkerPool) watch(w *ssrWorker) {
+	err := w.cmd.Wait()
+	fmt.Printf("Warning: SSR worker exited: %v\n", err)
+
+	p.mu.Lock()
+	p.healthy--
+	p.mu.Unlock()
+
+	replacement, err := spawnSSRWorker(p.scriptPath)
+	if err != nil {
+		fmt.Printf("Warning: failed to respawn SSR worker: %v\n", err)
+		return
+	}
+
+	p.mu.Lock()
+	p.healthy++
+	p.mu.Unlock()
+
+	p.available <- replacement
+	go p.watch(replacement)
+}
+
+func (p *ssrWorkerPool) isHealthy() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.healthy > 0
+}
+
+// drop kills a worker that render found unhealthy instead of requeuing
+// it. watch is already running for w from when it was spawned, so
+// killing it is enough: watch observes cmd.Wait() return, decrements
+// healthy, and spawns+requeues its replacement - no need to duplicate
+// that bookkeeping here.
+func (p *ssrWorkerPool) drop(w *ssrWorker) {
+	fmt.Printf("Warning: dropping unhealthy SSR worker (pid %d)\n", w.cmd.Process.Pid)
+	_ = w.cmd.Process.Kill()
+}
+
+func (p *ssrWorkerPool) render(ctx context.Context, req ssrRenderRequest) (ssrRenderResponse, error) {
+	select {
+	case w := <-p.available:
+		resp, err := w.render(ctx, req)
+		if w.isDead() {
+			p.drop(w)
+		} else {
+			p.available <- w
+		}
+		return resp, err
+	case <-ctx.Done():
+		return ssrRenderResponse{}, ctx.Err()
+	}
+}
+
+// ssrPool is the active SSR worker pool, nil unless SSR_ENABLED=true and
+// the workers started successfully.
+var ssrPool *ssrWorkerPool
+
+// ssrCacheKey namespaces rendered fragments separately from the raw
+// component:* cache, keyed on the props that were rendered with.
+func ssrCacheKey(componentType, lang string, props json.RawMessage) string {
+	return fmt.Sprintf("ssr:component:%s:%s:%s", componentType, lang, sha256Hex(props))
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// renderSSREndpoint handles ?render=ssr: it serves a cached fragment if
+// one exists for these props, otherwise renders via the worker pool and
+// caches the result. If the pool is unavailable or unhealthy, it
+// degrades to the raw-template response instead of failing the request.
+func renderSSREndpoint(c *gin.Context, component *LocalizedComponent) {
+	props := json.RawMessage(c.DefaultQuery("props", "{}"))
+	cacheKey := ssrCacheKey(component.ComponentType, component.Language, props)
+
+	if redisClient != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), RedisTimeout)
+		val, err := redisClient.Get(ctx, cacheKey).Result()
+		cancel()
+		if err == nil {
+			etag := sha256Hex([]byte(val))
+			if c.GetHeader("If-None-Match") == etag {
+				c.Status(http.StatusNotModified)
+				return
+			}
+			c.Header("ETag", etag)
+			c.Data(http.StatusOK, "application/json", []byte(val))
+			return
+		}
+	}
+
+	if ssrPool == nil || !ssrPool.isHealthy() {
+		degradeToRawTemplate(c, component)
+		return
+	}
+
+	var decodedProps map[string]interface{}
+	if err := json.Unmarshal(props, &decodedProps); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid props: " + err.Error()})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), SSRRenderTimeout)
+	defer cancel()
+
+	resp, err := ssrPool.render(ctx, ssrRenderRequest{
+		Template:      component.Template,
+		LocalizedData: component.LocalizedData,
+		Props:         decodedProps,
+	})
+	if err != nil {
+		fmt.Printf("Warning: SSR render failed, degrading to raw template: %v\n", err)
+		degradeToRawTemplate(c, component)
+		return
+	}
+
+	payload, err := json.Marshal(resp)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if redisClient != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), RedisTimeout)
+		if err := redisClient.Set(ctx, cacheKey, payload, SSRCacheTTL).Err(); err != nil {
+			fmt.Printf("Warning: failed to cache SSR fragment: %v\n", err)
 		}
+		cancel()
 	}
+
+	c.Header("ETag", sha256Hex(payload))
+	c.Data(http.StatusOK, "application/json", payload)
+}
+
+// degradeToRawTemplate serves the plain JSX-template response, used when
+// SSR was requested but the worker pool can't currently serve it.
+func degradeToRawTemplate(c *gin.Context, component *LocalizedComponent) {
+	c.Header("X-SSR-Degraded", "true")
+	response := *component
+	c.JSON(http.StatusOK, response)
 }
 
 // ComponentTemplate represents a React component template
@@ -165,8 +934,10 @@ type LocalizedComponent struct {
 	Cached        bool              `json:"cached,omitempty"`
 }
 
-// Localization database
-var localizationDB = map[string]map[string]string{
+// defaultLocaleStrings seeds the built-in locale catalog used when
+// LOCALES_DIR is unset, so the service still has translations out of the
+// box.
+var defaultLocaleStrings = map[string]map[string]string{
 	"en": {
 		"welcome_title":      "Welcome to Our App",
 		"welcome_subtitle":   "Your journey starts here",
@@ -237,17 +1008,723 @@ var localizationDB = map[string]map[string]string{
 	},
 }
 
-// Component templates
-var componentTemplates = map[string]ComponentTemplate{
-	"welcome": {
-		ComponentName: "WelcomeComponent",
-		ComponentType: "functional",
-		Template: `
-import React from 'react';
+// LocaleCatalog resolves translation strings for a language, including
+// fallback chains (e.g. fr-CA -> fr -> en) and CLDR-style plural/select
+// variants. Implementations may be backed by an in-memory map or by
+// files on disk that can be hot-reloaded.
+type LocaleCatalog interface {
+	// Lookup returns the raw (possibly ICU MessageFormat) string for key
+	// in lang, walking the fallback chain if lang doesn't have it.
+	Lookup(lang, key string) (string, bool)
+	// Render resolves and interpolates key for lang using args, handling
+	// plural/select MessageFormat blocks. args["count"], if present,
+	// drives plural category selection.
+	Render(lang, key string, args map[string]string) (string, bool)
+	// Languages lists every language the catalog has data for.
+	Languages() []string
+	// Keys lists every translation key available for lang (via fallback).
+	Keys(lang string) []string
+	// SetKey creates or overwrites a single key for lang, used by the
+	// admin API to apply runtime translation edits.
+	SetKey(lang, key, value string)
+	// DeleteKey removes a single key from lang, if present.
+	DeleteKey(lang, key string)
+}
 
-const WelcomeComponent = ({ className = "welcome-container" }) => {
-  return (
-    <div className={className}>
+// localeFallbacks declares explicit fallback chains for locale variants,
+// e.g. Canadian French falls back to France French before English.
+var localeFallbacks = map[string][]string{
+	"fr-CA": {"fr-CA", "fr", "en"},
+}
+
+// fallbackChain returns the ordered list of languages to try for lang,
+// ending in "en" unless lang already is or reduces to "en". Explicit
+// chains in localeFallbacks take priority; otherwise it strips
+// "-REGION" suffixes one at a time (e.g. "es-MX" -> "es" -> "en").
+func fallbackChain(lang string) []string {
+	if chain, ok := localeFallbacks[lang]; ok {
+		return chain
+	}
+
+	var chain []string
+	for cur := lang; ; {
+		chain = append(chain, cur)
+		idx := strings.LastIndex(cur, "-")
+		if idx == -1 {
+			break
+		}
+		cur = cur[:idx]
+	}
+	if chain[len(chain)-1] != "en" {
+		chain = append(chain, "en")
+	}
+	return chain
+}
+
+// pluralCategory maps a count to a CLDR plural category for the given
+// language. This only implements the "one"/"other" distinction our
+// supported languages need; a fuller ICU plural-rules table would be
+// needed to support languages with more categories (e.g. Arabic, Polish).
+func pluralCategory(lang string, count int) string {
+	switch strings.SplitN(lang, "-", 2)[0] {
+	case "fr":
+		if count == 0 || count == 1 {
+			return "one"
+		}
+		return "other"
+	default:
+		if count == 1 {
+			return "one"
+		}
+		return "other"
+	}
+}
+
+// matchingBrace returns the index of the '}' that closes the '{' at
+// open, accounting for nested braces, or -1 if unbalanced.
+func matchingBrace(s string, open int) int {
+	depth := 0
+	for i := open; i < len(s); i++ {
+		switch s[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// parseICUCases splits the "one {...} other {...}" portion of an ICU
+// plural/select block into a label -> body map.
+func parseICUCases(body string) map[string]string {
+	cases := make(map[string]string)
+	i := 0
+	for i < len(body) {
+		for i < len(body) && (body[i] == ' ' || body[i] == '\t' || body[i] == '\n') {
+			i++
+		}
+		start := i
+		for i < len(body) && body[i] != '{' {
+			i++
+		}
+		if i >= len(body) {
+			break
+		}
+		label := strings.TrimSpace(body[start:i])
+		end := matchingBrace(body, i)
+		if end == -1 {
+			break
+		}
+		cases[label] = body[i+1 : end]
+		i = end + 1
+	}
+	return cases
+}
+
+// evalICU walks s looking for "{argName, plural|select, ...}" blocks,
+// evaluates the one matching arg/count, and substitutes "#" with the
+// argument's value inside the chosen branch. Text outside of a
+// recognized block, or a block whose arg is missing, is left untouched.
+func evalICU(s string, lang string, args map[string]string) string {
+	var sb strings.Builder
+	i := 0
+	for i < len(s) {
+		if s[i] != '{' {
+			sb.WriteByte(s[i])
+			i++
+			continue
+		}
+		end := matchingBrace(s, i)
+		if end == -1 {
+			sb.WriteByte(s[i])
+			i++
+			continue
+		}
+		rendered, ok := evalICUBlock(s[i+1:end], lang, args)
+		if !ok {
+			sb.WriteString(s[i : end+1])
+		} else {
+			sb.WriteString(rendered)
+		}
+		i = end + 1
+	}
+	return sb.String()
+}
+
+func evalICUBlock(inner, lang string, args map[string]string) (string, bool) {
+	parts := strings.SplitN(inner, ",", 3)
+	if len(parts) != 3 {
+		return "", false
+	}
+	argName := strings.TrimSpace(parts[0])
+	kind := strings.TrimSpace(parts[1])
+	argVal, ok := args[argName]
+	if !ok {
+		return "", false
+	}
+
+	cases := parseICUCases(parts[2])
+
+	var selector string
+	switch kind {
+	case "plural":
+		selector = pluralCategory(lang, atoiOrZero(argVal))
+	case "select":
+		selector = argVal
+	default:
+		return "", false
+	}
+	chosen, ok := cases[selector]
+	if !ok {
+		chosen, ok = cases["other"]
+		if !ok {
+			return "", false
+		}
+	}
+
+	chosen = strings.ReplaceAll(chosen, "#", argVal)
+	return evalICU(chosen, lang, args), true
+}
+
+func atoiOrZero(s string) int {
+	n := 0
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return 0
+		}
+		n = n*10 + int(r-'0')
+	}
+	return n
+}
+
+// memoryLocaleCatalog is a LocaleCatalog backed entirely by an in-memory
+// map, used both as the built-in default and as the storage layer inside
+// fileLocaleCatalog.
+type memoryLocaleCatalog struct {
+	mu   sync.RWMutex
+	data map[string]map[string]string
+}
+
+func newMemoryLocaleCatalog(seed map[string]map[string]string) *memoryLocaleCatalog {
+	data := make(map[string]map[string]string, len(seed))
+	for lang, strs := range seed {
+		copied := make(map[string]string, len(strs))
+		for k, v := range strs {
+			copied[k] = v
+		}
+		data[lang] = copied
+	}
+	return &memoryLocaleCatalog{data: data}
+}
+
+func (m *memoryLocaleCatalog) Lookup(lang, key string) (string, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, candidate := range fallbackChain(lang) {
+		if strs, ok := m.data[candidate]; ok {
+			if val, ok := strs[key]; ok {
+				return val, true
+			}
+		}
+	}
+	return "", false
+}
+
+func (m *memoryLocaleCatalog) Render(lang, key string, args map[string]string) (string, bool) {
+	raw, ok := m.Lookup(lang, key)
+	if !ok {
+		return "", false
+	}
+	return evalICU(raw, lang, args), true
+}
+
+func (m *memoryLocaleCatalog) Languages() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	langs := make([]string, 0, len(m.data))
+	for lang := range m.data {
+		langs = append(langs, lang)
+	}
+	return langs
+}
+
+func (m *memoryLocaleCatalog) Keys(lang string) []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	seen := make(map[string]struct{})
+	for _, candidate := range fallbackChain(lang) {
+		for key := range m.data[candidate] {
+			seen[key] = struct{}{}
+		}
+	}
+	keys := make([]string, 0, len(seen))
+	for key := range seen {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func (m *memoryLocaleCatalog) replaceLanguage(lang string, strs map[string]string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.data[lang] = strs
+}
+
+func (m *memoryLocaleCatalog) SetKey(lang, key, value string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.data[lang] == nil {
+		m.data[lang] = make(map[string]string)
+	}
+	m.data[lang][key] = value
+}
+
+func (m *memoryLocaleCatalog) DeleteKey(lang, key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.data[lang], key)
+}
+
+// fileLocaleCatalog is a LocaleCatalog whose contents are loaded from
+// `*.json`, `*.po`, or `*.icu.json` files under a directory (one file per
+// language, named `<lang>.<ext>`), and kept in sync with an
+// fileLocaleWatcher.
+type fileLocaleCatalog struct {
+	*memoryLocaleCatalog
+	dir string
+}
+
+// NewFileLocaleCatalog loads every locale file in dir and returns a
+// catalog serving their contents. Supported extensions are .json
+// (flat key -> string map), .po (gettext msgid/msgstr pairs), and
+// .icu.json (flat key -> ICU MessageFormat string map).
+func NewFileLocaleCatalog(dir string) (*fileLocaleCatalog, error) {
+	catalog := &fileLocaleCatalog{
+		memoryLocaleCatalog: newMemoryLocaleCatalog(nil),
+		dir:                 dir,
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading locales dir: %w", err)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		lang, strs, err := loadLocaleFile(dir, entry.Name())
+		if err != nil {
+			fmt.Printf("Warning: failed to load locale file %s: %v\n", entry.Name(), err)
+			continue
+		}
+		if strs != nil {
+			catalog.replaceLanguage(lang, strs)
+		}
+	}
+	return catalog, nil
+}
+
+// loadLocaleFile loads a single locale file, returning its language code
+// (derived from the filename) and parsed strings. A nil map with a nil
+// error means the file's extension isn't a recognized locale format.
+func loadLocaleFile(dir, name string) (string, map[string]string, error) {
+	path := dir + string(os.PathSeparator) + name
+	switch {
+	case strings.HasSuffix(name, ".icu.json"):
+		lang := strings.TrimSuffix(name, ".icu.json")
+		strs, err := loadJSONLocale(path)
+		return lang, strs, err
+	case strings.HasSuffix(name, ".json"):
+		lang := strings.TrimSuffix(name, ".json")
+		strs, err := loadJSONLocale(path)
+		return lang, strs, err
+	case strings.HasSuffix(name, ".po"):
+		lang := strings.TrimSuffix(name, ".po")
+		strs, err := loadPOLocale(path)
+		return lang, strs, err
+	default:
+		return "", nil, nil
+	}
+}
+
+func loadJSONLocale(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	strs := make(map[string]string)
+	if err := json.Unmarshal(data, &strs); err != nil {
+		return nil, err
+	}
+	return strs, nil
+}
+
+// loadPOLocale parses a minimal subset of gettext .po syntax: sequential
+// `msgid "..."` / `msgstr "..."` pairs. Comments and headers (msgid "")
+// are skipped. Multi-line strings and msgid_plural are not supported.
+func loadPOLocale(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	strs := make(map[string]string)
+	lines := strings.Split(string(data), "\n")
+	var pendingID string
+	haveID := false
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, "msgid "):
+			pendingID = unquotePO(strings.TrimPrefix(line, "msgid "))
+			haveID = pendingID != ""
+		case strings.HasPrefix(line, "msgstr ") && haveID:
+			strs[pendingID] = unquotePO(strings.TrimPrefix(line, "msgstr "))
+			haveID = false
+		}
+	}
+	return strs, nil
+}
+
+func unquotePO(s string) string {
+	s = strings.TrimSpace(s)
+	s = strings.TrimPrefix(s, `"`)
+	s = strings.TrimSuffix(s, `"`)
+	return s
+}
+
+// fileLocaleWatcher periodically polls locale files for mtime changes and
+// reloads any that changed, invalidating only the cache keys for the
+// affected language so translators can iterate without a restart. The
+// first poll reloads every file, since no mtimes have been recorded yet.
+type fileLocaleWatcher struct {
+	catalog *fileLocaleCatalog
+	mtimes  map[string]time.Time
+}
+
+func startLocaleWatcher(catalog *fileLocaleCatalog, interval time.Duration) *fileLocaleWatcher {
+	w := &fileLocaleWatcher{catalog: catalog, mtimes: make(map[string]time.Time)}
+	go w.run(interval)
+	return w
+}
+
+func (w *fileLocaleWatcher) run(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		w.pollOnce()
+	}
+}
+
+func (w *fileLocaleWatcher) pollOnce() {
+	entries, err := os.ReadDir(w.catalog.dir)
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		if prev, ok := w.mtimes[entry.Name()]; ok && !info.ModTime().After(prev) {
+			continue
+		}
+		w.mtimes[entry.Name()] = info.ModTime()
+
+		lang, strs, err := loadLocaleFile(w.catalog.dir, entry.Name())
+		if err != nil || strs == nil {
+			continue
+		}
+		w.catalog.replaceLanguage(lang, strs)
+		// Re-apply the admin translation overrides held in Redis, which
+		// replacing the language's strings just dropped.
+		if redisClient != nil {
+			hydrateTranslationsFromRedis()
+		}
+		invalidateLocaleCache(lang)
+		fmt.Printf("Reloaded locale %q from %s\n", lang, entry.Name())
+	}
+}
+
+// invalidateLocaleCache drops every cached component keyed to lang from
+// both the in-memory TTL cache and Redis, so the next request picks up
+// the freshly reloaded strings.
+func invalidateLocaleCache(lang string) {
+	// component cache keys are "component:<type>:<lang>", optionally
+	// followed by argsCacheSuffix's ":<hash>"; TTLCache only supports
+	// prefix deletion, so walk the map directly and match the lang part.
+	componentCache.mu.Lock()
+	for key, element := range componentCache.cache {
+		if _, keyLang, ok := parseComponentKey(key); ok && keyLang == lang {
+			componentCache.lruList.Remove(element)
+			delete(componentCache.cache, key)
+			delete(componentCache.timestamps, key)
+		}
+	}
+	componentCache.mu.Unlock()
+
+	if redisClient == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), RedisTimeout)
+	defer cancel()
+
+	for _, pattern := range []string{"component:*:" + lang, "component:*:" + lang + ":*"} {
+		var cursor uint64
+		for {
+			keys, next, err := redisClient.Scan(ctx, cursor, pattern, 3000).Result()
+			if err != nil {
+				fmt.Printf("Warning: Redis scan during locale invalidation failed: %v\n", err)
+				return
+			}
+			if len(keys) > 0 {
+				if err := redisClient.Del(ctx, keys...).Err(); err != nil {
+					fmt.Printf("Warning: Redis del during locale invalidation failed: %v\n", err)
+				}
+			}
+			cursor = next
+			if cursor == 0 {
+				break
+			}
+		}
+	}
+}
+
+// localeCatalog is the active LocaleCatalog, set up in main() from
+// LOCALES_DIR (falling back to the built-in strings when unset).
+var localeCatalog LocaleCatalog = newMemoryLocaleCatalog(defaultLocaleStrings)
+
+// --- Admin-managed persistence -------------------------------------------
+//
+// The admin API treats Redis as the source of truth for component
+// templates and translation strings created/edited at runtime; the
+// componentTemplates map and localeCatalog only act as read-through (or,
+// for locales, write-through) caches in front of it. Keys live in their
+// own namespaces so they don't collide with the rendered-component cache:
+//   template:<component_type>            -> JSON-encoded ComponentTemplate
+//   translation:<lang>:<key>              -> plain string value
+
+const invalidationChannel = "locale-manager:cache-invalidation"
+
+// invalidationMessage is published over Redis pub/sub whenever an admin
+// write changes a template or translation, so peer instances know to
+// flush their caches for the affected key.
+type invalidationMessage struct {
+	Kind string `json:"kind"` // "template" or "translation"
+	Key  string `json:"key"`  // component_type, or "<lang>:<key>"
+}
+
+func fetchComponentTemplateFromRedis(componentType string) (ComponentTemplate, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), RedisTimeout)
+	defer cancel()
+
+	val, err := redisClient.Get(ctx, "template:"+componentType).Result()
+	if err != nil {
+		return ComponentTemplate{}, err
+	}
+	var t ComponentTemplate
+	if err := json.Unmarshal([]byte(val), &t); err != nil {
+		return ComponentTemplate{}, err
+	}
+	return t, nil
+}
+
+func persistComponentTemplate(componentType string, t ComponentTemplate) error {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), RedisTimeout)
+	defer cancel()
+	return redisClient.Set(ctx, "template:"+componentType, data, 0).Err()
+}
+
+func deletePersistedComponentTemplate(componentType string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), RedisTimeout)
+	defer cancel()
+	return redisClient.Del(ctx, "template:"+componentType).Err()
+}
+
+func translationRedisKey(lang, key string) string {
+	return fmt.Sprintf("translation:%s:%s", lang, key)
+}
+
+func persistTranslation(lang, key, value string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), RedisTimeout)
+	defer cancel()
+	return redisClient.Set(ctx, translationRedisKey(lang, key), value, 0).Err()
+}
+
+func deletePersistedTranslation(lang, key string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), RedisTimeout)
+	defer cancel()
+	return redisClient.Del(ctx, translationRedisKey(lang, key)).Err()
+}
+
+// hydrateAdminOverridesFromRedis loads every admin-managed template and
+// translation out of Redis at startup, so a freshly started instance
+// reflects prior admin writes instead of just the built-in defaults.
+func hydrateAdminOverridesFromRedis() {
+	if redisClient == nil {
+		return
+	}
+	hydrateTemplatesFromRedis()
+	hydrateTranslationsFromRedis()
+}
+
+func hydrateTemplatesFromRedis() {
+	ctx, cancel := context.WithTimeout(context.Background(), RedisTimeout)
+	defer cancel()
+
+	var cursor uint64
+	for {
+		keys, next, err := redisClient.Scan(ctx, cursor, "template:*", DefaultScanCount).Result()
+		if err != nil {
+			fmt.Printf("Warning: failed to scan template overrides: %v\n", err)
+			return
+		}
+		for _, key := range keys {
+			componentType := strings.TrimPrefix(key, "template:")
+			t, err := fetchComponentTemplateFromRedis(componentType)
+			if err != nil {
+				continue
+			}
+			setComponentTemplate(componentType, t)
+		}
+		cursor = next
+		if cursor == 0 {
+			return
+		}
+	}
+}
+
+func hydrateTranslationsFromRedis() {
+	ctx, cancel := context.WithTimeout(context.Background(), RedisTimeout)
+	defer cancel()
+
+	var cursor uint64
+	for {
+		keys, next, err := redisClient.Scan(ctx, cursor, "translation:*", DefaultScanCount).Result()
+		if err != nil {
+			fmt.Printf("Warning: failed to scan translation overrides: %v\n", err)
+			return
+		}
+		for _, key := range keys {
+			rest := strings.TrimPrefix(key, "translation:")
+			parts := strings.SplitN(rest, ":", 2)
+			if len(parts) != 2 {
+				continue
+			}
+			val, err := redisClient.Get(ctx, key).Result()
+			if err != nil {
+				continue
+			}
+			localeCatalog.SetKey(parts[0], parts[1], val)
+		}
+		cursor = next
+		if cursor == 0 {
+			return
+		}
+	}
+}
+
+// publishInvalidation notifies peer instances that a template or
+// translation changed, so they can evict/refresh their caches.
+func publishInvalidation(kind, key string) {
+	if redisClient == nil {
+		return
+	}
+	payload, err := json.Marshal(invalidationMessage{Kind: kind, Key: key})
+	if err != nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), RedisTimeout)
+	defer cancel()
+	if err := redisClient.Publish(ctx, invalidationChannel, payload).Err(); err != nil {
+		fmt.Printf("Warning: failed to publish cache invalidation: %v\n", err)
+	}
+}
+
+// subscribeInvalidations starts a goroutine that applies invalidation
+// messages published by any instance's admin writes (including this
+// one's own, which is harmless - it just re-does work already done).
+func subscribeInvalidations() {
+	if redisClient == nil {
+		return
+	}
+	pubsub := redisClient.Subscribe(context.Background(), invalidationChannel)
+	go func() {
+		defer pubsub.Close()
+		for msg := range pubsub.Channel() {
+			handleInvalidationMessage(msg.Payload)
+		}
+	}()
+}
+
+func handleInvalidationMessage(payload string) {
+	var msg invalidationMessage
+	if err := json.Unmarshal([]byte(payload), &msg); err != nil {
+		return
+	}
+
+	switch msg.Kind {
+	case "template":
+		reloadComponentTemplateFromRedis(msg.Key)
+		componentCache.DeletePrefix(fmt.Sprintf("component:%s:", msg.Key))
+	case "translation":
+		parts := strings.SplitN(msg.Key, ":", 2)
+		if len(parts) != 2 {
+			return
+		}
+		reloadTranslationFromRedis(parts[0], parts[1])
+		invalidateLocaleCache(parts[0])
+	}
+}
+
+func reloadComponentTemplateFromRedis(componentType string) {
+	if redisClient == nil {
+		return
+	}
+	t, err := fetchComponentTemplateFromRedis(componentType)
+	if err != nil {
+		deleteComponentTemplate(componentType)
+		return
+	}
+	setComponentTemplate(componentType, t)
+}
+
+func reloadTranslationFromRedis(lang, key string) {
+	ctx, cancel := context.WithTimeout(context.Background(), RedisTimeout)
+	defer cancel()
+	val, err := redisClient.Get(ctx, translationRedisKey(lang, key)).Result()
+	if err != nil {
+		localeCatalog.DeleteKey(lang, key)
+		return
+	}
+	localeCatalog.SetKey(lang, key, val)
+}
+
+// Component templates. Guarded by componentTemplatesMu since the admin
+// API (OIDCAuth-protected) can create/update/delete entries at runtime;
+// use getComponentTemplate/setComponentTemplate/deleteComponentTemplate
+// rather than touching the map directly.
+var componentTemplatesMu sync.RWMutex
+var componentTemplates = map[string]ComponentTemplate{
+	"welcome": {
+		ComponentName: "WelcomeComponent",
+		ComponentType: "functional",
+		Template: `
+import React from 'react';
+
+const WelcomeComponent = ({ className = "welcome-container" }) => {
+  return (
+    <div className={className}>
       <div className="welcome-wrapper">
         <header className="welcome-header">
           <h1 className="welcome-title" data-l10n="welcome_title">
@@ -343,56 +1820,306 @@ const UserProfileComponent = ({ className = "user-profile-container" }) => {
   );
 };
 
-export default UserProfileComponent;
-`,
-		RequiredKeys: []string{"user_profile_title", "user_profile_edit"},
-	},
-	"footer": {
-		ComponentName: "FooterComponent",
-		ComponentType: "functional",
-		Template: `
-import React from 'react';
+export default UserProfileComponent;
+`,
+		RequiredKeys: []string{"user_profile_title", "user_profile_edit"},
+	},
+	"footer": {
+		ComponentName: "FooterComponent",
+		ComponentType: "functional",
+		Template: `
+import React from 'react';
+
+const FooterComponent = ({ className = "footer-container" }) => {
+  return (
+    <footer className={className}>
+      <div className="footer-content">
+        <p className="footer-copyright" data-l10n="footer_copyright">
+          {l10n.footer_copyright}
+        </p>
+      </div>
+    </footer>
+  );
+};
+
+export default FooterComponent;
+`,
+		RequiredKeys: []string{"footer_copyright"},
+	},
+}
+
+// getComponentTemplate returns the template for componentType, checking
+// the in-memory map first and falling back to Redis (the admin API's
+// source of truth) on a miss, caching whatever it finds back into the
+// map. This is what makes componentTemplates a read-through cache rather
+// than the sole store once the admin API is in play.
+func getComponentTemplate(componentType string) (ComponentTemplate, bool) {
+	componentTemplatesMu.RLock()
+	t, ok := componentTemplates[componentType]
+	componentTemplatesMu.RUnlock()
+	if ok {
+		return t, true
+	}
+
+	if redisClient == nil {
+		return ComponentTemplate{}, false
+	}
+	t, err := fetchComponentTemplateFromRedis(componentType)
+	if err != nil {
+		return ComponentTemplate{}, false
+	}
+	setComponentTemplate(componentType, t)
+	return t, true
+}
+
+func setComponentTemplate(componentType string, t ComponentTemplate) {
+	componentTemplatesMu.Lock()
+	defer componentTemplatesMu.Unlock()
+	componentTemplates[componentType] = t
+}
+
+func deleteComponentTemplate(componentType string) {
+	componentTemplatesMu.Lock()
+	defer componentTemplatesMu.Unlock()
+	delete(componentTemplates, componentType)
+}
+
+func listComponentTemplateTypes() []string {
+	componentTemplatesMu.RLock()
+	defer componentTemplatesMu.RUnlock()
+	types := make([]string, 0, len(componentTemplates))
+	for t := range componentTemplates {
+		types = append(types, t)
+	}
+	return types
+}
+
+// Global cache instances
+var componentCache = NewTTLCache(CacheMaxSize, CacheTTL)
+var redisClient redis.UniversalClient
+var redisConfig RedisConfig
+
+// concurrencyLimiters is the active limiter registry, set up in main()
+// and read by healthCheck to report per-route limiter stats.
+var concurrencyLimiters *limiterRegistry
+
+// BUG FIX: Use context with timeout for Redis operations instead of background context
+// This prevents requests from hanging indefinitely if Redis is slow/unresponsive
+const RedisTimeout = 2 * time.Second
+
+// RedisConfig describes how to connect to Redis, whether that's a single
+// node, a Sentinel-monitored primary/replica set, or a cluster. It exists
+// as its own type (rather than reading os.Getenv calls inline) so tests
+// can build a client without touching the environment.
+type RedisConfig struct {
+	Mode string // "standalone", "sentinel", or "cluster"
+
+	Addr string // standalone
+
+	SentinelAddrs []string
+	MasterName    string
+
+	ClusterAddrs []string
+
+	Password string
+	DB       int
+
+	TLSEnabled bool
+	TLSCAPath  string
+	TLSCert    string
+	TLSKey     string
+}
+
+// redisConfigFromEnv builds a RedisConfig from environment variables,
+// defaulting to a standalone client pointed at localhost when unset.
+func redisConfigFromEnv() RedisConfig {
+	cfg := RedisConfig{
+		Mode:     strings.ToLower(os.Getenv("REDIS_MODE")),
+		Addr:     os.Getenv("REDIS_ADDR"),
+		Password: os.Getenv("REDIS_PASSWORD"),
+		DB:       0,
+
+		MasterName: os.Getenv("REDIS_MASTER_NAME"),
+
+		TLSEnabled: strings.EqualFold(os.Getenv("REDIS_TLS"), "true"),
+		TLSCAPath:  os.Getenv("REDIS_TLS_CA"),
+		TLSCert:    os.Getenv("REDIS_TLS_CERT"),
+		TLSKey:     os.Getenv("REDIS_TLS_KEY"),
+	}
+
+	if cfg.Mode == "" {
+		cfg.Mode = "standalone"
+	}
+	if cfg.Addr == "" {
+		cfg.Addr = "localhost:6379"
+	}
+	if addrs := os.Getenv("REDIS_SENTINEL_ADDRS"); addrs != "" {
+		cfg.SentinelAddrs = splitAndTrim(addrs)
+	}
+	if addrs := os.Getenv("REDIS_CLUSTER_ADDRS"); addrs != "" {
+		cfg.ClusterAddrs = splitAndTrim(addrs)
+	}
+
+	return cfg
+}
+
+// splitAndTrim splits a comma-separated env value into a slice of trimmed,
+// non-empty entries.
+func splitAndTrim(s string) []string {
+	parts := strings.Split(s, ",")
+	out := make([]string, 0, len(parts))
+	for _, p := range parts {
+		p = strings.TrimSpace(p)
+		if p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// buildTLSConfig assembles a *tls.Config from a RedisConfig's TLS fields,
+// returning nil when TLS is disabled.
+func buildTLSConfig(cfg RedisConfig) (*tls.Config, error) {
+	if !cfg.TLSEnabled {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{}
+
+	if cfg.TLSCAPath != "" {
+		caCert, err := os.ReadFile(cfg.TLSCAPath)
+		if err != nil {
+			return nil, fmt.Errorf("reading redis CA cert: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no valid certificates found in %s", cfg.TLSCAPath)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.TLSCert != "" && cfg.TLSKey != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.TLSCert, cfg.TLSKey)
+		if err != nil {
+			return nil, fmt.Errorf("loading redis client cert/key: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// NewRedisClient builds a redis.UniversalClient for the given config. The
+// returned client satisfies the same Cmdable interface whether it's backed
+// by a single node, a Sentinel failover group, or a cluster, so callers
+// like getFromRedis/setInRedis don't need to know which mode is active.
+func NewRedisClient(cfg RedisConfig) (redis.UniversalClient, error) {
+	tlsConfig, err := buildTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	switch cfg.Mode {
+	case "sentinel":
+		return redis.NewFailoverClient(&redis.FailoverOptions{
+			MasterName:    cfg.MasterName,
+			SentinelAddrs: cfg.SentinelAddrs,
+			Password:      cfg.Password,
+			DB:            cfg.DB,
+			TLSConfig:     tlsConfig,
+		}), nil
+	case "cluster":
+		return redis.NewClusterClient(&redis.ClusterOptions{
+			Addrs:     cfg.ClusterAddrs,
+			Password:  cfg.Password,
+			TLSConfig: tlsConfig,
+		}), nil
+	case "standalone", "":
+		return redis.NewClient(&redis.Options{
+			Addr:      cfg.Addr,
+			Password:  cfg.Password,
+			DB:        cfg.DB,
+			TLSConfig: tlsConfig,
+		}), nil
+	default:
+		return nil, fmt.Errorf("unsupported REDIS_MODE %q", cfg.Mode)
+	}
+}
 
-const FooterComponent = ({ className = "footer-container" }) => {
-  return (
-    <footer className={className}>
-      <div className="footer-content">
-        <p className="footer-copyright" data-l10n="footer_copyright">
-          {l10n.footer_copyright}
-        </p>
-      </div>
-    </footer>
-  );
-};
+// initRedis initializes the Redis client from environment configuration.
+func initRedis() (redis.UniversalClient, RedisConfig) {
+	cfg := redisConfigFromEnv()
 
-export default FooterComponent;
-`,
-		RequiredKeys: []string{"footer_copyright"},
-	},
+	client, err := NewRedisClient(cfg)
+	if err != nil {
+		fmt.Printf("Warning: failed to build redis client: %v (continuing without Redis)\n", err)
+		return nil, cfg
+	}
+
+	return client, cfg
 }
 
-// Global cache instances
-var componentCache = NewTTLCache(CacheMaxSize, CacheTTL)
-var redisClient *redis.Client
+// reachableRedisNodes pings each address independently and returns how many
+// responded. Used by the health check to report Sentinel/cluster node
+// availability without requiring a cluster-aware client.
+func reachableRedisNodes(addrs []string, password string) int {
+	reachable := 0
+	for _, addr := range addrs {
+		func() {
+			probe := redis.NewClient(&redis.Options{Addr: addr, Password: password})
+			defer probe.Close()
 
-// BUG FIX: Use context with timeout for Redis operations instead of background context
-// This prevents requests from hanging indefinitely if Redis is slow/unresponsive
-const RedisTimeout = 2 * time.Second
+			ctx, cancel := context.WithTimeout(context.Background(), RedisTimeout)
+			defer cancel()
 
-// initRedis initializes the Redis client
-func initRedis() *redis.Client {
-	redisAddr := os.Getenv("REDIS_ADDR")
-	if redisAddr == "" {
-		redisAddr = "localhost:6379"
+			if err := probe.Ping(ctx).Err(); err == nil {
+				reachable++
+			}
+		}()
 	}
+	return reachable
+}
 
-	client := redis.NewClient(&redis.Options{
-		Addr:     redisAddr,
-		Password: os.Getenv("REDIS_PASSWORD"),
-		DB:       0,
-	})
+// sessionCursorEntry is the scan position a client has paged to, so a
+// later request with the same session_id can resume instead of
+// restarting the SCAN from 0.
+type sessionCursorEntry struct {
+	cursor    uint64
+	expiresAt time.Time
+}
+
+// sessionCursors holds one sessionCursorEntry per client-supplied
+// session_id. A sync.Map fits better than a mutex-guarded map here since
+// entries are independent per key and churn is high (one touch per page).
+var sessionCursors sync.Map
+
+// loadSessionCursor returns the stored cursor for sessionID, if any and
+// not expired.
+func loadSessionCursor(sessionID string) (uint64, bool) {
+	val, ok := sessionCursors.Load(sessionID)
+	if !ok {
+		return 0, false
+	}
+	entry := val.(sessionCursorEntry)
+	if time.Now().After(entry.expiresAt) {
+		sessionCursors.Delete(sessionID)
+		return 0, false
+	}
+	return entry.cursor, true
+}
 
-	return client
+// storeSessionCursor records cursor as sessionID's resume point. A
+// next_cursor of 0 means the scan completed, so the entry is cleared
+// instead of kept around until it expires.
+func storeSessionCursor(sessionID string, cursor uint64) {
+	if cursor == 0 {
+		sessionCursors.Delete(sessionID)
+		return
+	}
+	sessionCursors.Store(sessionID, sessionCursorEntry{
+		cursor:    cursor,
+		expiresAt: time.Now().Add(SessionCursorTTL),
+	})
 }
 
 // getFromRedis retrieves a component from Redis with timeout
@@ -441,25 +2168,59 @@ func interpolateTemplate(template string, localizedData map[string]string) strin
 	})
 }
 
+// renderArgsFromQuery pulls the ICU MessageFormat selectors templates can
+// reference (a plural "count" and a "gender" select) out of the request's
+// query string, for LocaleCatalog.Render to evaluate plural/select blocks
+// with. Both are optional; a key with no such block in it ignores them.
+func renderArgsFromQuery(c *gin.Context) map[string]string {
+	args := make(map[string]string)
+	for _, key := range []string{"count", "gender"} {
+		if v := c.Query(key); v != "" {
+			args[key] = v
+		}
+	}
+	return args
+}
+
+// argsCacheSuffix returns a deterministic cache-key suffix for args, so
+// renders that pick different plural/select branches (e.g. count=1 vs
+// count=2) don't collide under the same cache entry. Empty for the
+// common case of no args, to keep existing cache keys unchanged.
+func argsCacheSuffix(args map[string]string) string {
+	if len(args) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(args))
+	for k := range args {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	for _, k := range keys {
+		sb.WriteString(k)
+		sb.WriteByte('=')
+		sb.WriteString(args[k])
+		sb.WriteByte('&')
+	}
+	return ":" + sha256Hex([]byte(sb.String()))
+}
+
 // getLocalizedComponent generates a localized React component
-func getLocalizedComponent(componentType, lang string) (*LocalizedComponent, error) {
-	template, exists := componentTemplates[componentType]
+func getLocalizedComponent(componentType, lang string, args map[string]string) (*LocalizedComponent, error) {
+	template, exists := getComponentTemplate(componentType)
 	if !exists {
 		return nil, fmt.Errorf("component type '%s' not found", componentType)
 	}
 
 	// BUG FIX: Track actual language used (might fall back to English)
-	actualLang := lang
-	strings, exists := localizationDB[lang]
-	if !exists {
-		strings = localizationDB["en"]
-		actualLang = "en" // Reflect that we're actually using English
-	}
+	actualLang := resolvedLanguage(lang)
 
-	// Get only the required keys for this component
+	// Get only the required keys for this component, evaluating any
+	// plural/select MessageFormat blocks against args.
 	componentStrings := make(map[string]string)
 	for _, key := range template.RequiredKeys {
-		if value, ok := strings[key]; ok {
+		if value, ok := localeCatalog.Render(lang, key, args); ok {
 			componentStrings[key] = value
 		} else {
 			componentStrings[key] = fmt.Sprintf("[%s]", key)
@@ -497,48 +2258,55 @@ func healthCheck(c *gin.Context) {
 		}
 	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"status":            "healthy",
-		"service":           "localization-manager-backend",
-		"version":           "0.1.0",
-		"cache_size":        componentCache.Size(),
-		"concurrency_limit": ConcurrencyLimit,
-		"redis_status":      redisStatus,
-	})
-}
+	health := gin.H{
+		"status":       "healthy",
+		"service":      "localization-manager-backend",
+		"version":      "0.1.0",
+		"cache_size":   componentCache.Size(),
+		"redis_status": redisStatus,
+		"redis_mode":   redisConfig.Mode,
+	}
 
-// Get localized component handler
-func getLocalizedComponentEndpoint(c *gin.Context) {
-	componentType := c.Param("component_type")
-	lang := c.DefaultQuery("lang", "en")
+	if concurrencyLimiters != nil {
+		health["limiters"] = concurrencyLimiters.snapshot()
+	}
 
-	// BUG FIX: Normalize language to actual supported language for cache key
-	// This prevents cache pollution (same content under multiple keys) and
-	// stale cache issues when new languages are added
-	actualLang := lang
-	if _, exists := localizationDB[lang]; !exists {
-		actualLang = "en"
+	health["ssr_enabled"] = ssrPool != nil
+	if ssrPool != nil {
+		health["ssr_healthy"] = ssrPool.isHealthy()
 	}
-	cacheKey := fmt.Sprintf("component:%s:%s", componentType, actualLang)
 
+	switch redisConfig.Mode {
+	case "sentinel":
+		health["redis_reachable_nodes"] = reachableRedisNodes(redisConfig.SentinelAddrs, redisConfig.Password)
+		health["redis_known_nodes"] = len(redisConfig.SentinelAddrs)
+	case "cluster":
+		health["redis_reachable_nodes"] = reachableRedisNodes(redisConfig.ClusterAddrs, redisConfig.Password)
+		health["redis_known_nodes"] = len(redisConfig.ClusterAddrs)
+	}
+
+	c.JSON(http.StatusOK, health)
+}
+
+// resolveLocalizedComponent returns the localized component for
+// componentType/lang, checking the TTL cache then Redis before falling
+// back to generating it fresh, per the same tiered-cache logic both the
+// raw-template and SSR render modes need.
+func resolveLocalizedComponent(componentType, lang string, args map[string]string, cacheKey string) (component *LocalizedComponent, cached bool, err error) {
 	// Check TTL cache first
-	if cached, found := componentCache.Get(cacheKey); found {
-		component := cached.(*LocalizedComponent)
+	if hit, found := componentCache.Get(cacheKey); found {
+		c := hit.(*LocalizedComponent)
 		// BUG FIX: Removed redundant cache refresh operations
 		// The Get() already updates LRU order, no need to Put() again
 		// Redis refresh on every hit is wasteful - removed
-		response := *component
-		response.Cached = true
-		c.JSON(http.StatusOK, response)
-		return
+		return c, true, nil
 	}
 
 	// TTL cache miss, check Redis
 	if redisClient != nil {
-		component, err := getFromRedis(cacheKey)
-		if err == nil && component != nil {
+		if c, err := getFromRedis(cacheKey); err == nil && c != nil {
 			// Found in Redis, store in TTL cache
-			componentCache.Put(cacheKey, component)
+			componentCache.Put(cacheKey, c)
 
 			// BUG FIX: Use EXPIRE to refresh TTL instead of re-setting the whole value
 			ctx, cancel := context.WithTimeout(context.Background(), RedisTimeout)
@@ -548,20 +2316,43 @@ func getLocalizedComponentEndpoint(c *gin.Context) {
 			}
 			cancel()
 
-			response := *component
-			response.Cached = true
-			c.JSON(http.StatusOK, response)
-			return
+			return c, true, nil
 		}
 	}
 
 	// Both caches missed, generate component
-	component, err := getLocalizedComponent(componentType, lang)
+	c, err := getLocalizedComponent(componentType, lang, args)
 	if err != nil {
-		availableComponents := make([]string, 0, len(componentTemplates))
-		for key := range componentTemplates {
-			availableComponents = append(availableComponents, key)
+		return nil, false, err
+	}
+
+	// Store in both caches
+	componentCache.Put(cacheKey, c)
+	if redisClient != nil {
+		if err := setInRedis(cacheKey, c); err != nil {
+			// BUG FIX: Log Redis errors instead of silently ignoring
+			fmt.Printf("Warning: Failed to cache in Redis: %v\n", err)
 		}
+	}
+
+	return c, false, nil
+}
+
+// Get localized component handler
+func getLocalizedComponentEndpoint(c *gin.Context) {
+	componentType := c.Param("component_type")
+	lang := c.DefaultQuery("lang", "en")
+
+	// BUG FIX: Normalize language to actual supported language for cache key
+	// This prevents cache pollution (same content under multiple keys) and
+	// stale cache issues when new languages are added
+	actualLang := resolvedLanguage(lang)
+	args := renderArgsFromQuery(c)
+	cacheKey := fmt.Sprintf("component:%s:%s%s", componentType, actualLang, argsCacheSuffix(args))
+
+	component, cached, err := resolveLocalizedComponent(componentType, lang, args, cacheKey)
+	if err != nil {
+		availableComponents := listComponentTemplateTypes()
 		c.JSON(http.StatusNotFound, gin.H{
 			"error":                err.Error(),
 			"available_components": availableComponents,
@@ -569,17 +2360,283 @@ func getLocalizedComponentEndpoint(c *gin.Context) {
 		return
 	}
 
-	// Store in both caches
-	componentCache.Put(cacheKey, component)
-	if redisClient != nil {
-		if err := setInRedis(cacheKey, component); err != nil {
-			// BUG FIX: Log Redis errors instead of silently ignoring
-			fmt.Printf("Warning: Failed to cache in Redis: %v\n", err)
+	if c.Query("render") == "ssr" {
+		renderSSREndpoint(c, component)
+		return
+	}
+
+	response := *component
+	response.Cached = cached
+	c.JSON(http.StatusOK, response)
+}
+
+// listLocalesEndpoint lists every language the active catalog has data
+// for, for tooling like a translator dashboard.
+func listLocalesEndpoint(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"locales": localeCatalog.Languages(),
+	})
+}
+
+// listLocaleKeysEndpoint lists every translation key available for a
+// language, resolved through its fallback chain.
+func listLocaleKeysEndpoint(c *gin.Context) {
+	lang := c.Param("lang")
+	c.JSON(http.StatusOK, gin.H{
+		"lang": lang,
+		"keys": localeCatalog.Keys(lang),
+	})
+}
+
+// parseComponentKey splits a "component:<type>:<lang>" cache key, with or
+// without argsCacheSuffix's ":<hash>" suffix, into its type and language
+// parts.
+func parseComponentKey(key string) (componentType, lang string, ok bool) {
+	parts := strings.SplitN(key, ":", 4)
+	if len(parts) < 3 || parts[0] != "component" {
+		return "", "", false
+	}
+	return parts[1], parts[2], true
+}
+
+// scanCursorParams reads the cursor/count/session_id query params shared
+// by the cursor-paginated listing endpoints, resuming from a stored
+// session cursor when the caller doesn't supply one explicitly.
+func scanCursorParams(c *gin.Context) (cursor uint64, count int64, sessionID string) {
+	sessionID = c.Query("session_id")
+
+	count = DefaultScanCount
+	if raw := c.Query("count"); raw != "" {
+		if parsed, err := strconv.ParseInt(raw, 10, 64); err == nil && parsed > 0 {
+			count = parsed
+		}
+	}
+
+	if raw := c.Query("cursor"); raw != "" {
+		if parsed, err := strconv.ParseUint(raw, 10, 64); err == nil {
+			cursor = parsed
+		}
+	} else if sessionID != "" {
+		if stored, ok := loadSessionCursor(sessionID); ok {
+			cursor = stored
+		}
+	}
+
+	return cursor, count, sessionID
+}
+
+// listComponentsEndpoint pages through every cached component:* key using
+// Redis SCAN rather than KEYS, so a UI can page through a large cache
+// without blocking the Redis event loop. Cursor state is resumable via
+// session_id across requests that omit an explicit cursor.
+func listComponentsEndpoint(c *gin.Context) {
+	if redisClient == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "redis is unavailable"})
+		return
+	}
+
+	cursor, count, sessionID := scanCursorParams(c)
+
+	ctx, cancel := context.WithTimeout(context.Background(), RedisTimeout)
+	defer cancel()
+
+	keys, next, err := redisClient.Scan(ctx, cursor, "component:*", count).Result()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	items := make([]gin.H, 0, len(keys))
+	for _, key := range keys {
+		componentType, lang, ok := parseComponentKey(key)
+		if !ok {
+			continue
+		}
+		items = append(items, gin.H{"component_type": componentType, "language": lang})
+	}
+
+	if sessionID != "" {
+		storeSessionCursor(sessionID, next)
+	}
+
+	c.JSON(http.StatusOK, gin.H{"items": items, "next_cursor": next})
+}
+
+// listComponentLanguagesEndpoint pages through the component:<type>:* keys
+// for a single component type, returning the languages it's cached under.
+func listComponentLanguagesEndpoint(c *gin.Context) {
+	if redisClient == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "redis is unavailable"})
+		return
+	}
+
+	componentType := c.Param("component_type")
+	cursor, count, sessionID := scanCursorParams(c)
+
+	ctx, cancel := context.WithTimeout(context.Background(), RedisTimeout)
+	defer cancel()
+
+	pattern := fmt.Sprintf("component:%s:*", componentType)
+	keys, next, err := redisClient.Scan(ctx, cursor, pattern, count).Result()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	items := make([]gin.H, 0, len(keys))
+	for _, key := range keys {
+		_, lang, ok := parseComponentKey(key)
+		if !ok {
+			continue
 		}
+		items = append(items, gin.H{"language": lang})
+	}
+
+	if sessionID != "" {
+		storeSessionCursor(sessionID, next)
+	}
+
+	c.JSON(http.StatusOK, gin.H{"items": items, "next_cursor": next})
+}
+
+// adminComponentRequest is the request body for creating/updating a
+// ComponentTemplate through the admin API.
+type adminComponentRequest struct {
+	ComponentName string   `json:"component_name"`
+	ComponentType string   `json:"component_type"`
+	Template      string   `json:"template"`
+	RequiredKeys  []string `json:"required_keys"`
+}
+
+// adminListComponentsEndpoint lists every known component type.
+func adminListComponentsEndpoint(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"components": listComponentTemplateTypes()})
+}
+
+// adminUpsertComponentEndpoint creates or replaces a ComponentTemplate,
+// persisting it to Redis and notifying peer instances to invalidate
+// their rendered-component cache for it.
+func adminUpsertComponentEndpoint(c *gin.Context) {
+	if redisClient == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "redis is unavailable"})
+		return
+	}
+
+	var req adminComponentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if req.ComponentType == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "component_type is required"})
+		return
+	}
+
+	template := ComponentTemplate{
+		ComponentName: req.ComponentName,
+		ComponentType: req.ComponentType,
+		Template:      req.Template,
+		RequiredKeys:  req.RequiredKeys,
+	}
+
+	if err := persistComponentTemplate(req.ComponentType, template); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	setComponentTemplate(req.ComponentType, template)
+	componentCache.DeletePrefix(fmt.Sprintf("component:%s:", req.ComponentType))
+	publishInvalidation("template", req.ComponentType)
+
+	c.JSON(http.StatusOK, gin.H{
+		"component_type": req.ComponentType,
+		"updated_by":     c.GetString("oidc_username"),
+	})
+}
+
+// adminDeleteComponentEndpoint removes a ComponentTemplate.
+func adminDeleteComponentEndpoint(c *gin.Context) {
+	if redisClient == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "redis is unavailable"})
+		return
+	}
+
+	componentType := c.Param("component_type")
+	if err := deletePersistedComponentTemplate(componentType); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	deleteComponentTemplate(componentType)
+	componentCache.DeletePrefix(fmt.Sprintf("component:%s:", componentType))
+	publishInvalidation("template", componentType)
+
+	c.JSON(http.StatusOK, gin.H{
+		"deleted_component_type": componentType,
+		"deleted_by":             c.GetString("oidc_username"),
+	})
+}
+
+// adminLocaleKeyRequest is the request body for setting a translation
+// string through the admin API.
+type adminLocaleKeyRequest struct {
+	Value string `json:"value"`
+}
+
+// adminUpsertLocaleKeyEndpoint creates or overwrites a single translation
+// string, persisting it to Redis and notifying peers to invalidate any
+// cached components rendered in that language.
+func adminUpsertLocaleKeyEndpoint(c *gin.Context) {
+	if redisClient == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "redis is unavailable"})
+		return
+	}
+
+	lang := c.Param("lang")
+	key := c.Param("key")
+
+	var req adminLocaleKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := persistTranslation(lang, key, req.Value); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	localeCatalog.SetKey(lang, key, req.Value)
+	invalidateLocaleCache(lang)
+	publishInvalidation("translation", lang+":"+key)
+
+	c.JSON(http.StatusOK, gin.H{
+		"lang":       lang,
+		"key":        key,
+		"updated_by": c.GetString("oidc_username"),
+	})
+}
+
+// adminDeleteLocaleKeyEndpoint removes a single translation string.
+func adminDeleteLocaleKeyEndpoint(c *gin.Context) {
+	if redisClient == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "redis is unavailable"})
+		return
+	}
+
+	lang := c.Param("lang")
+	key := c.Param("key")
+
+	if err := deletePersistedTranslation(lang, key); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
 	}
+	localeCatalog.DeleteKey(lang, key)
+	invalidateLocaleCache(lang)
+	publishInvalidation("translation", lang+":"+key)
 
-	component.Cached = false
-	c.JSON(http.StatusOK, component)
+	c.JSON(http.StatusOK, gin.H{
+		"lang":       lang,
+		"key":        key,
+		"deleted_by": c.GetString("oidc_username"),
+	})
 }
 
 func main() {
@@ -587,18 +2644,61 @@ func main() {
 	// gin.SetMode(gin.ReleaseMode)
 
 	// Initialize Redis
-	redisClient = initRedis()
-	defer redisClient.Close()
+	redisClient, redisConfig = initRedis()
+	if redisClient != nil {
+		defer redisClient.Close()
 
-	// Test Redis connection
-	ctx, cancel := context.WithTimeout(context.Background(), RedisTimeout)
-	if err := redisClient.Ping(ctx).Err(); err != nil {
-		fmt.Printf("‚ö†Ô∏è  Redis connection failed: %v (continuing without Redis)\n", err)
-		redisClient = nil
-	} else {
-		fmt.Println("‚úÖ Redis connected successfully")
+		// Test Redis connection
+		ctx, cancel := context.WithTimeout(context.Background(), RedisTimeout)
+		if err := redisClient.Ping(ctx).Err(); err != nil {
+			fmt.Printf("‚ö†Ô∏è  Redis connection failed: %v (continuing without Redis)\n", err)
+			redisClient = nil
+		} else {
+			fmt.Printf("‚úÖ Redis connected successfully (mode=%s)\n", redisConfig.Mode)
+		}
+		cancel()
+	}
+
+	// Locale catalog: load from LOCALES_DIR and start watching it for
+	// changes if configured, otherwise keep the built-in default catalog.
+	if localesDir := os.Getenv("LOCALES_DIR"); localesDir != "" {
+		fileCatalog, err := NewFileLocaleCatalog(localesDir)
+		if err != nil {
+			fmt.Printf("Warning: failed to load LOCALES_DIR=%s: %v (using built-in locales)\n", localesDir, err)
+		} else {
+			localeCatalog = fileCatalog
+			startLocaleWatcher(fileCatalog, 5*time.Second)
+			fmt.Printf("üåê Loaded locales from %s\n", localesDir)
+		}
+	}
+
+	// Pick up any templates/translations the admin API has already
+	// persisted, and keep watching for peer writes.
+	hydrateAdminOverridesFromRedis()
+	subscribeInvalidations()
+
+	// SSR worker pool: optional, and the handler degrades to raw-template
+	// mode if it's unset or fails to start.
+	if strings.EqualFold(os.Getenv("SSR_ENABLED"), "true") {
+		poolSize := DefaultSSRPoolSize
+		if raw := os.Getenv("SSR_POOL_SIZE"); raw != "" {
+			if parsed, err := strconv.Atoi(raw); err == nil && parsed > 0 {
+				poolSize = parsed
+			}
+		}
+		scriptPath := os.Getenv("SSR_WORKER_SCRIPT")
+		if scriptPath == "" {
+			scriptPath = "ssr/worker.js"
+		}
+
+		pool, err := newSSRWorkerPool(poolSize, scriptPath)
+		if err != nil {
+			fmt.Printf("Warning: SSR worker pool failed to start: %v (render=ssr will degrade to raw templates)\n", err)
+		} else {
+			ssrPool = pool
+			fmt.Printf("üé≠ SSR worker pool started (%d workers)\n", poolSize)
+		}
 	}
-	cancel()
 
 	router := gin.Default()
 
@@ -607,9 +2707,38 @@ func main() {
 	router.GET("/health", healthCheck)
 
 	// Apply concurrency limiter only to API routes
+	limiterCfg := DefaultLimiterConfig()
+	limiterCfg.RouteBudgets["/api/component/:component_type"] = ConcurrencyLimit
+	concurrencyLimiters = newLimiterRegistry(limiterCfg)
+
 	api := router.Group("/api")
-	api.Use(ConcurrencyLimiter(ConcurrencyLimit))
+	api.Use(ConcurrencyLimiter(concurrencyLimiters))
 	api.GET("/component/:component_type", getLocalizedComponentEndpoint)
+	api.GET("/locales", listLocalesEndpoint)
+	api.GET("/locales/:lang/keys", listLocaleKeysEndpoint)
+	api.GET("/components", listComponentsEndpoint)
+	api.GET("/components/:component_type/languages", listComponentLanguagesEndpoint)
+
+	// Admin CRUD surface, gated on a valid OIDC token and group
+	// membership. Disabled (routes not registered) if OIDC_ISSUER_URL
+	// isn't configured, discovery fails, or OIDC_REQUIRED_GROUP isn't
+	// set, so an operator can't accidentally expose writes unsecured or
+	// to any caller who merely holds a valid token for the issuer -
+	// those writes feed templates the SSR worker pool compiles and runs.
+	oidcCfg := oidcConfigFromEnv()
+	if oidcCfg.RequiredGroup == "" {
+		fmt.Println("Warning: admin API disabled, OIDC_REQUIRED_GROUP is not set")
+	} else if verifier, err := newOIDCVerifier(context.Background(), oidcCfg); err != nil {
+		fmt.Printf("Warning: admin API disabled, OIDC setup failed: %v\n", err)
+	} else {
+		admin := router.Group("/admin")
+		admin.Use(OIDCAuth(verifier, oidcCfg))
+		admin.GET("/components", adminListComponentsEndpoint)
+		admin.PUT("/components", adminUpsertComponentEndpoint)
+		admin.DELETE("/components/:component_type", adminDeleteComponentEndpoint)
+		admin.PUT("/locales/:lang/:key", adminUpsertLocaleKeyEndpoint)
+		admin.DELETE("/locales/:lang/:key", adminDeleteLocaleKeyEndpoint)
+	}
 
 	// Start server
 	fmt.Println("üöÄ Localization Manager Backend starting on :8000")
@@ -623,18 +2752,35 @@ func main() {
 
 // Helper function to get component keys
 func getComponentKeys() []string {
-	keys := make([]string, 0, len(componentTemplates))
-	for key := range componentTemplates {
-		keys = append(keys, key)
-	}
-	return keys
+	return listComponentTemplateTypes()
 }
 
 // Helper function to get language keys
 func getLanguageKeys() []string {
-	keys := make([]string, 0, len(localizationDB))
-	for key := range localizationDB {
-		keys = append(keys, key)
+	return localeCatalog.Languages()
+}
+
+// containsLanguage reports whether lang is present in langs.
+func containsLanguage(langs []string, lang string) bool {
+	for _, l := range langs {
+		if l == lang {
+			return true
+		}
 	}
-	return keys
+	return false
+}
+
+// resolvedLanguage returns the language lang actually resolves to in the
+// active catalog, walking the same fallback chain Lookup uses. An exact
+// match against Languages() isn't enough: Lookup("fr-CA", key) may be
+// satisfied by "fr" even though "fr-CA" itself isn't in the catalog, and
+// callers need that actual language for response metadata and cache keys.
+func resolvedLanguage(lang string) string {
+	known := localeCatalog.Languages()
+	for _, candidate := range fallbackChain(lang) {
+		if containsLanguage(known, candidate) {
+			return candidate
+		}
+	}
+	return "en"
 }